@@ -146,10 +146,6 @@ func Complete(b AbstractInterface) bool {
 // Transitive checks the following condition:
 //	 (xBy and yBz) ⇒  xBz for any x, y, z ∈ X ≡ Universe()
 func Transitive(b AbstractInterface) bool {
-	if !Complete(b) {
-		return false
-	}
-
 	elems := b.Universe().Elements()
 
 	// n^3 :(
@@ -176,7 +172,7 @@ func Symmetric(b AbstractInterface) bool {
 	for _, x := range elems {
 		for _, y := range elems {
 			if b.ContainsRelation(x, y) {
-				if !b.ContainsRelation(x, y) {
+				if !b.ContainsRelation(y, x) {
 					return false
 				}
 			}
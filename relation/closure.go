@@ -0,0 +1,92 @@
+package relation
+
+// --- Closures {{{
+
+// ReflexiveClosure returns the smallest relation containing b that is
+// Reflexive: it contains every pair b already contains, plus (x, x) for
+// every x ∈ Universe().
+func ReflexiveClosure(b AbstractInterface) Interface {
+	u := b.Universe()
+	elems := u.Elements()
+
+	r := New(u)
+	for _, x := range elems {
+		for _, y := range elems {
+			if b.ContainsRelation(x, y) {
+				r.AddRelation(x, y)
+			}
+		}
+		r.AddRelation(x, x)
+	}
+
+	return r
+}
+
+// SymmetricClosure returns the smallest relation containing b that is
+// Symmetric: for every stored pair (x, y), the result also contains (y, x).
+func SymmetricClosure(b AbstractInterface) Interface {
+	elems := b.Universe().Elements()
+
+	r := New(b.Universe())
+	for _, x := range elems {
+		for _, y := range elems {
+			if b.ContainsRelation(x, y) {
+				r.AddRelation(x, y)
+				r.AddRelation(y, x)
+			}
+		}
+	}
+
+	return r
+}
+
+// TransitiveClosure returns the smallest relation containing b that is
+// Transitive. It is computed with Warshall's algorithm: build an n×n
+// boolean matrix M from ContainsRelation, where n = len(Universe().Elements()),
+// then for k, i, j range over 0..n-1, M[i][j] = M[i][j] || (M[i][k] && M[k][j]).
+func TransitiveClosure(b AbstractInterface) Interface {
+	u := b.Universe()
+	elems := u.Elements()
+	n := len(elems)
+
+	m := make([][]bool, n)
+	for i := range m {
+		m[i] = make([]bool, n)
+		for j := range m[i] {
+			m[i][j] = b.ContainsRelation(elems[i], elems[j])
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if !m[i][k] {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if m[k][j] {
+					m[i][j] = true
+				}
+			}
+		}
+	}
+
+	r := New(u)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if m[i][j] {
+				r.AddRelation(elems[i], elems[j])
+			}
+		}
+	}
+
+	return r
+}
+
+// EquivalenceClosure returns the smallest relation containing b that is an
+// Equivalence: the transitive closure of the symmetric closure of the
+// reflexive closure of b.
+func EquivalenceClosure(b AbstractInterface) Interface {
+	return TransitiveClosure(SymmetricClosure(ReflexiveClosure(b)))
+}
+
+// --- }}}
@@ -0,0 +1,72 @@
+package relation
+
+import "testing"
+
+func TestReflexiveClosure(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+	b := New(u)
+	b.AddRelation(1, 2)
+
+	c := ReflexiveClosure(b)
+
+	for _, e := range []int{1, 2, 3} {
+		if !c.ContainsRelation(e, e) {
+			t.Errorf("ReflexiveClosure: expected %d to relate to itself", e)
+		}
+	}
+	if !c.ContainsRelation(1, 2) {
+		t.Errorf("ReflexiveClosure: expected original relation (1, 2) to be preserved")
+	}
+	if c.ContainsRelation(2, 1) {
+		t.Errorf("ReflexiveClosure: did not expect (2, 1); reflexive closure should not add symmetry")
+	}
+}
+
+func TestSymmetricClosure(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+	b := New(u)
+	b.AddRelation(1, 2)
+
+	c := SymmetricClosure(b)
+
+	if !c.ContainsRelation(1, 2) || !c.ContainsRelation(2, 1) {
+		t.Errorf("SymmetricClosure: expected both (1, 2) and (2, 1)")
+	}
+	if c.ContainsRelation(1, 1) {
+		t.Errorf("SymmetricClosure: did not expect (1, 1); symmetric closure should not add reflexivity")
+	}
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+	b := New(u)
+	b.AddRelation(1, 2)
+	b.AddRelation(2, 3)
+
+	c := TransitiveClosure(b)
+
+	if !c.ContainsRelation(1, 3) {
+		t.Errorf("TransitiveClosure: expected (1, 3) from chaining (1, 2) and (2, 3)")
+	}
+	if !c.ContainsRelation(1, 2) || !c.ContainsRelation(2, 3) {
+		t.Errorf("TransitiveClosure: expected original relations to be preserved")
+	}
+}
+
+func TestEquivalenceClosure(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+	b := New(u)
+	b.AddRelation(1, 2)
+
+	c := EquivalenceClosure(b)
+
+	if ok, w := IsEquivalence(c); !ok {
+		t.Fatalf("EquivalenceClosure: result is not an equivalence, witness %+v", w)
+	}
+	if !c.ContainsRelation(1, 2) || !c.ContainsRelation(2, 1) {
+		t.Errorf("EquivalenceClosure: expected 1 and 2 to be related both ways")
+	}
+	if c.ContainsRelation(1, 3) {
+		t.Errorf("EquivalenceClosure: did not expect 1 and 3 to be related")
+	}
+}
@@ -0,0 +1,40 @@
+package relation
+
+import "github.com/nlandolfi/set"
+
+// --- Preorder-Derived Relations {{{
+
+// SymmetricKernel returns the symmetric kernel of b:
+//  x ~ y ⇔ xBy and yBx
+// When b is a Preorder, this is the induced equivalence — the
+// "indifference" relation in the weak-order reading already modeled by
+// WeakOrder.
+func SymmetricKernel(b AbstractInterface) AbstractInterface {
+	return NewFunctionBinaryRelation(b.Universe(), func(x, y set.Element) bool {
+		return b.ContainsRelation(x, y) && b.ContainsRelation(y, x)
+	})
+}
+
+// AsymmetricKernel returns the asymmetric kernel of b:
+//  x < y ⇔ xBy and not yBx
+// When b is a Preorder, this is the induced strict partial order — the
+// "strict preference" relation in the weak-order reading already modeled
+// by WeakOrder.
+func AsymmetricKernel(b AbstractInterface) AbstractInterface {
+	return NewFunctionBinaryRelation(b.Universe(), func(x, y set.Element) bool {
+		return b.ContainsRelation(x, y) && !b.ContainsRelation(y, x)
+	})
+}
+
+// Preorder checks that b is Reflexive and Transitive. Unlike WeakOrder,
+// Preorder does not additionally require Complete.
+func Preorder(b AbstractInterface) bool {
+	return Reflexive(b) && Transitive(b)
+}
+
+// PartialOrder checks that b is a Preorder and additionally AntiSymmetric.
+func PartialOrder(b AbstractInterface) bool {
+	return Preorder(b) && AntiSymmetric(b)
+}
+
+// --- }}}
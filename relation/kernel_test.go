@@ -0,0 +1,58 @@
+package relation
+
+import "testing"
+
+func TestSymmetricAndAsymmetricKernel(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	b := New(u)
+	for _, e := range []int{1, 2, 3} {
+		b.AddRelation(e, e)
+	}
+	b.AddRelation(1, 2)
+	b.AddRelation(2, 1)
+	b.AddRelation(2, 3)
+
+	sk := SymmetricKernel(b)
+	if !sk.ContainsRelation(1, 2) || !sk.ContainsRelation(2, 1) {
+		t.Errorf("SymmetricKernel: expected 1 and 2 to be related both ways")
+	}
+	if sk.ContainsRelation(2, 3) {
+		t.Errorf("SymmetricKernel: did not expect (2, 3); it is not related back")
+	}
+
+	ak := AsymmetricKernel(b)
+	if !ak.ContainsRelation(2, 3) {
+		t.Errorf("AsymmetricKernel: expected (2, 3); b holds one way only")
+	}
+	if ak.ContainsRelation(1, 2) || ak.ContainsRelation(2, 1) {
+		t.Errorf("AsymmetricKernel: did not expect (1, 2) or (2, 1); both hold")
+	}
+}
+
+func TestPreorderAndPartialOrder(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	b := New(u)
+	for _, e := range []int{1, 2, 3} {
+		b.AddRelation(e, e)
+	}
+	b.AddRelation(1, 2)
+	b.AddRelation(2, 3)
+	b.AddRelation(1, 3)
+
+	if !Preorder(b) {
+		t.Errorf("Preorder: expected b to be a preorder")
+	}
+	if !PartialOrder(b) {
+		t.Errorf("PartialOrder: expected b to be a partial order")
+	}
+
+	b.AddRelation(2, 1)
+	if PartialOrder(b) {
+		t.Errorf("PartialOrder: did not expect b to remain a partial order once antisymmetry breaks")
+	}
+	if !Preorder(b) {
+		t.Errorf("Preorder: adding (2, 1) should not affect reflexivity/transitivity")
+	}
+}
@@ -0,0 +1,250 @@
+package relation
+
+import "github.com/nlandolfi/set"
+
+// --- Matrix Binary Relation Implementation {{{
+
+// NewMatrix constructs a new Interface backed by a dense boolean matrix
+// (a []uint64 bitset of n·⌈n/64⌉ words, each row padded to whole words)
+// rather than binaryRelation's map of maps. The element-to-index mapping is
+// snapshotted from universe.Elements() at construction time and is fixed
+// thereafter. This backing makes composition much cheaper on large
+// universes: see Compose, Union, Intersection, Complement, Converse, and
+// Power below. The property checks (Transitive, Complete and the rest) do
+// not use it; they test ContainsRelation pair by pair.
+func NewMatrix(universe set.Interface) Interface {
+	return newMatrix(universe, universe.Elements())
+}
+
+// matrixBinaryRelation is the dense, bitset-backed representation of a
+// binary relation over a fixed snapshot of elems.
+type matrixBinaryRelation struct {
+	universe set.Interface
+	elems    []set.Element
+	index    map[set.Element]int
+	words    int // words per row, ⌈n/64⌉
+	bits     []uint64
+}
+
+// newMatrix builds an empty matrixBinaryRelation over universe, indexing
+// elems in the given order. Composition and the Union/Intersection/Converse
+// family rely on the two operands sharing the same elems ordering, so they
+// route through this rather than NewMatrix directly.
+func newMatrix(universe set.Interface, elems []set.Element) *matrixBinaryRelation {
+	n := len(elems)
+	words := (n + 63) / 64
+
+	index := make(map[set.Element]int, n)
+	for i, e := range elems {
+		index[e] = i
+	}
+
+	return &matrixBinaryRelation{
+		universe: universe,
+		elems:    elems,
+		index:    index,
+		words:    words,
+		bits:     make([]uint64, n*words),
+	}
+}
+
+// toMatrixOn materializes r as a matrixBinaryRelation indexed by elems (the
+// caller's choice of ordering, not r.Universe().Elements()), so that two
+// relations passed to the same binary operation always agree on indexing.
+//
+// This always rebuilds from r.ContainsRelation, even when r is itself a
+// *matrixBinaryRelation: r's own elems snapshot may have been indexed in a
+// different order than the caller's (set.Interface.Elements() is not
+// guaranteed stable across calls), so reusing r's bits positionally would
+// silently transpose entries onto the wrong indices.
+func toMatrixOn(universe set.Interface, elems []set.Element, r AbstractInterface) *matrixBinaryRelation {
+	m := newMatrix(universe, elems)
+	for i, x := range elems {
+		for j, y := range elems {
+			if r.ContainsRelation(x, y) {
+				m.setBit(i, j)
+			}
+		}
+	}
+
+	return m
+}
+
+func (m *matrixBinaryRelation) Universe() set.Interface {
+	return m.universe
+}
+
+func (m *matrixBinaryRelation) testBit(row, col int) bool {
+	off := row*m.words + col/64
+	return m.bits[off]&(1<<uint(col%64)) != 0
+}
+
+func (m *matrixBinaryRelation) setBit(row, col int) {
+	off := row*m.words + col/64
+	m.bits[off] |= 1 << uint(col%64)
+}
+
+func (m *matrixBinaryRelation) clearBit(row, col int) {
+	off := row*m.words + col/64
+	m.bits[off] &^= 1 << uint(col%64)
+}
+
+func (m *matrixBinaryRelation) AddRelation(x, y set.Element) {
+	i, ok := m.index[x]
+	assert(ok, "(*matrixBinaryRelation).AddRelation: element 1 is not contained in universe")
+	j, ok := m.index[y]
+	assert(ok, "(*matrixBinaryRelation).AddRelation: element 2 is not contained in universe")
+
+	m.setBit(i, j)
+}
+
+func (m *matrixBinaryRelation) RemoveRelation(x, y set.Element) {
+	i, ok := m.index[x]
+	assert(ok, "(*matrixBinaryRelation).RemoveRelation: element 1 is not contained in universe")
+	j, ok := m.index[y]
+	assert(ok, "(*matrixBinaryRelation).RemoveRelation: element 2 is not contained in universe")
+
+	m.clearBit(i, j)
+}
+
+func (m *matrixBinaryRelation) ContainsRelation(x, y set.Element) bool {
+	i, ok := m.index[x]
+	assert(ok, "(*matrixBinaryRelation).ContainsRelation: element 1 is not contained in universe")
+	j, ok := m.index[y]
+	assert(ok, "(*matrixBinaryRelation).ContainsRelation: element 2 is not contained in universe")
+
+	return m.testBit(i, j)
+}
+
+// --- }}}
+
+// --- Matrix Algebra {{{
+
+// orRow sets dst's drow-th row to the bitwise OR of itself with src's
+// srow-th row, one 64-bit word at a time.
+func orRow(dst []uint64, drow int, src []uint64, srow, words int) {
+	doff, soff := drow*words, srow*words
+	for w := 0; w < words; w++ {
+		dst[doff+w] |= src[soff+w]
+	}
+}
+
+// Compose computes the relational composition a∘b, where
+//  (a∘b)(x, z) ⇔ ∃y. a(x, y) ∧ b(y, z)
+// a and b must share a Universe (see ComposableRelations). Composition is
+// computed as boolean matrix multiplication: row x of the result is the
+// bitwise OR, over every y with a(x, y), of b's row for y — 64 relations
+// resolved per word instead of one, which is a ~64× speedup over testing
+// ContainsRelation element by element.
+func Compose(a, b AbstractInterface) Interface {
+	assert(ComposableRelations([]AbstractInterface{a, b}), "relation.Compose: a and b must be defined over the same Universe")
+
+	u := a.Universe()
+	elems := u.Elements()
+	n := len(elems)
+
+	am := toMatrixOn(u, elems, a)
+	bm := toMatrixOn(u, elems, b)
+	out := newMatrix(u, elems)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if am.testBit(i, j) {
+				orRow(out.bits, i, bm.bits, j, out.words)
+			}
+		}
+	}
+
+	return out
+}
+
+// Power composes r with itself k times (k ≥ 1), i.e. r∘r∘...∘r (k copies).
+func Power(r AbstractInterface, k int) Interface {
+	assert(k >= 1, "relation.Power: k must be >= 1")
+
+	u := r.Universe()
+	base := toMatrixOn(u, u.Elements(), r)
+
+	result := AbstractInterface(base)
+	for i := 1; i < k; i++ {
+		result = Compose(result, base)
+	}
+
+	return toMatrixOn(u, u.Elements(), result)
+}
+
+// Union returns the relation containing (x, y) whenever a(x, y) ∨ b(x, y).
+// a and b must share a Universe.
+func Union(a, b AbstractInterface) Interface {
+	assert(ComposableRelations([]AbstractInterface{a, b}), "relation.Union: a and b must be defined over the same Universe")
+
+	u := a.Universe()
+	elems := u.Elements()
+
+	am := toMatrixOn(u, elems, a)
+	bm := toMatrixOn(u, elems, b)
+	out := newMatrix(u, elems)
+
+	for w := range out.bits {
+		out.bits[w] = am.bits[w] | bm.bits[w]
+	}
+
+	return out
+}
+
+// Intersection returns the relation containing (x, y) whenever a(x, y) ∧ b(x, y).
+// a and b must share a Universe.
+func Intersection(a, b AbstractInterface) Interface {
+	assert(ComposableRelations([]AbstractInterface{a, b}), "relation.Intersection: a and b must be defined over the same Universe")
+
+	u := a.Universe()
+	elems := u.Elements()
+
+	am := toMatrixOn(u, elems, a)
+	bm := toMatrixOn(u, elems, b)
+	out := newMatrix(u, elems)
+
+	for w := range out.bits {
+		out.bits[w] = am.bits[w] & bm.bits[w]
+	}
+
+	return out
+}
+
+// Complement returns the relation containing (x, y) whenever ¬a(x, y).
+func Complement(a AbstractInterface) Interface {
+	u := a.Universe()
+	elems := u.Elements()
+
+	am := toMatrixOn(u, elems, a)
+	out := newMatrix(u, elems)
+
+	for w := range out.bits {
+		out.bits[w] = ^am.bits[w]
+	}
+
+	return out
+}
+
+// Converse returns the relation containing (x, y) whenever a(y, x) — the
+// transpose of a's matrix.
+func Converse(a AbstractInterface) Interface {
+	u := a.Universe()
+	elems := u.Elements()
+	n := len(elems)
+
+	am := toMatrixOn(u, elems, a)
+	out := newMatrix(u, elems)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if am.testBit(i, j) {
+				out.setBit(j, i)
+			}
+		}
+	}
+
+	return out
+}
+
+// --- }}}
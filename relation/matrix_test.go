@@ -0,0 +1,99 @@
+package relation
+
+import "testing"
+
+// TestComposeRebuildsMatrixOperand is a regression test: an operand that is
+// already a *matrixBinaryRelation must not be reused positionally by
+// toMatrixOn, since its own elems snapshot can be indexed in a different
+// order than a second, independently-fetched universe.Elements() call.
+func TestComposeRebuildsMatrixOperand(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	r1 := NewMatrix(u)
+	r1.AddRelation(1, 2)
+
+	identity := New(u)
+	for _, e := range []int{1, 2, 3} {
+		identity.AddRelation(e, e)
+	}
+
+	c := Compose(r1, identity)
+
+	if !c.ContainsRelation(1, 2) {
+		t.Errorf("Compose(r1, identity): expected (1, 2); r1∘id should equal r1")
+	}
+	if c.ContainsRelation(2, 1) || c.ContainsRelation(1, 1) || c.ContainsRelation(1, 3) {
+		t.Errorf("Compose(r1, identity): unexpected extra relation in result")
+	}
+}
+
+func TestComposeGeneral(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	a := NewMatrix(u)
+	a.AddRelation(1, 2)
+
+	b := NewMatrix(u)
+	b.AddRelation(2, 3)
+
+	c := Compose(a, b)
+
+	if !c.ContainsRelation(1, 3) {
+		t.Errorf("Compose(a, b): expected (1, 3) via the intermediate 2")
+	}
+	if c.ContainsRelation(1, 2) || c.ContainsRelation(2, 3) {
+		t.Errorf("Compose(a, b): did not expect a or b's own pairs to survive composition")
+	}
+}
+
+func TestUnionIntersectionComplementConverse(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	a := NewMatrix(u)
+	a.AddRelation(1, 2)
+
+	b := NewMatrix(u)
+	b.AddRelation(2, 3)
+
+	union := Union(a, b)
+	if !union.ContainsRelation(1, 2) || !union.ContainsRelation(2, 3) {
+		t.Errorf("Union: expected both (1, 2) and (2, 3)")
+	}
+	if union.ContainsRelation(1, 3) {
+		t.Errorf("Union: did not expect (1, 3)")
+	}
+
+	inter := Intersection(a, union)
+	if !inter.ContainsRelation(1, 2) || inter.ContainsRelation(2, 3) {
+		t.Errorf("Intersection: expected exactly (1, 2)")
+	}
+
+	comp := Complement(a)
+	if comp.ContainsRelation(1, 2) {
+		t.Errorf("Complement: did not expect (1, 2)")
+	}
+	if !comp.ContainsRelation(2, 1) {
+		t.Errorf("Complement: expected (2, 1)")
+	}
+
+	conv := Converse(a)
+	if !conv.ContainsRelation(2, 1) || conv.ContainsRelation(1, 2) {
+		t.Errorf("Converse: expected (2, 1) only")
+	}
+}
+
+func TestPower(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	r := NewMatrix(u)
+	r.AddRelation(1, 2)
+	r.AddRelation(2, 3)
+
+	p2 := Power(r, 2)
+	if !p2.ContainsRelation(1, 3) {
+		t.Errorf("Power(r, 2): expected (1, 3) from composing r with itself")
+	}
+	if p2.ContainsRelation(1, 2) {
+		t.Errorf("Power(r, 2): did not expect (1, 2); r^2 should only contain length-2 paths")
+	}
+}
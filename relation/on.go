@@ -0,0 +1,28 @@
+package relation
+
+import "github.com/nlandolfi/set"
+
+// --- Pullback {{{
+
+// On returns the pullback of b along f: the relation B' on u defined by
+//	 x B' y ⇔ f(x) B f(y)
+// Many practical relations — comparison by key, ordering structs by a
+// field, equivalence modulo a hash — are most cleanly expressed by reusing
+// an existing relation on a projected value.
+func On(u set.Interface, f func(set.Element) set.Element, b AbstractInterface) AbstractInterface {
+	return NewFunctionBinaryRelation(u, func(x, y set.Element) bool {
+		return b.ContainsRelation(f(x), f(y))
+	})
+}
+
+// Equality returns the relation x = y over u, a ready-made equivalence.
+// On(u, f, Equality(codomain)) gives the kernel-of-f equivalence — x ~ y ⇔
+// f(x) = f(y) — whose EquivalenceClasses/Quotient are exactly the classes
+// induced by f.
+func Equality(u set.Interface) AbstractInterface {
+	return NewFunctionBinaryRelation(u, func(x, y set.Element) bool {
+		return x == y
+	})
+}
+
+// --- }}}
@@ -0,0 +1,40 @@
+package relation
+
+import (
+	"testing"
+
+	"github.com/nlandolfi/set"
+)
+
+func TestOnPullback(t *testing.T) {
+	u := newUniverse(-2, -1, 0, 1, 2)
+
+	abs := func(e set.Element) set.Element {
+		n := e.(int)
+		if n < 0 {
+			return -n
+		}
+		return n
+	}
+
+	eq := On(u, abs, Equality(u))
+
+	if !eq.ContainsRelation(-2, 2) {
+		t.Errorf("On: expected -2 ~ 2 under equality-of-abs")
+	}
+	if eq.ContainsRelation(-2, 1) {
+		t.Errorf("On: did not expect -2 ~ 1")
+	}
+}
+
+func TestEquality(t *testing.T) {
+	u := newUniverse(1, 2)
+	e := Equality(u)
+
+	if !e.ContainsRelation(1, 1) {
+		t.Errorf("Equality: expected 1 = 1")
+	}
+	if e.ContainsRelation(1, 2) {
+		t.Errorf("Equality: did not expect 1 = 2")
+	}
+}
@@ -0,0 +1,127 @@
+package relation
+
+import (
+	"errors"
+
+	"github.com/nlandolfi/set"
+)
+
+// ErrNotEquivalence is returned by EquivalenceClasses and Quotient when the
+// given relation is not Reflexive, Symmetric, and Transitive.
+var ErrNotEquivalence = errors.New("relation: not an equivalence relation")
+
+// --- Quotient {{{
+
+// EquivalenceClasses partitions Universe(b) into the equivalence classes
+// induced by b, returning ErrNotEquivalence if b is not Reflexive,
+// Symmetric, and Transitive.
+func EquivalenceClasses(b AbstractInterface) ([]set.Interface, error) {
+	uf, elems, err := equivalenceUnionFind(b)
+	if err != nil {
+		return nil, err
+	}
+
+	classes := make(map[set.Element]set.Interface)
+	for _, e := range elems {
+		root := uf.find(e)
+		c, ok := classes[root]
+		if !ok {
+			c = set.New()
+			classes[root] = c
+		}
+		c.Add(e)
+	}
+
+	result := make([]set.Interface, 0, len(classes))
+	for _, c := range classes {
+		result = append(result, c)
+	}
+
+	return result, nil
+}
+
+// Quotient returns the quotient set Universe(b)/~ induced by the
+// equivalence b — one canonical representative per class — together with
+// the projection π mapping each element of Universe(b) to its class's
+// representative. It returns ErrNotEquivalence if b is not Reflexive,
+// Symmetric, and Transitive.
+func Quotient(b AbstractInterface) (set.Interface, func(set.Element) set.Element, error) {
+	uf, elems, err := equivalenceUnionFind(b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reps := make(map[set.Element]set.Element)
+	q := set.New()
+	for _, e := range elems {
+		root := uf.find(e)
+		if _, ok := reps[root]; !ok {
+			reps[root] = root
+			q.Add(root)
+		}
+	}
+
+	pi := func(e set.Element) set.Element {
+		return reps[uf.find(e)]
+	}
+
+	return q, pi, nil
+}
+
+// equivalenceUnionFind validates that b is an equivalence relation and, if
+// so, returns a union-find over Universe().Elements() with every pair
+// (x, y) such that b.ContainsRelation(x, y) already merged.
+func equivalenceUnionFind(b AbstractInterface) (*unionFind, []set.Element, error) {
+	if !(Reflexive(b) && Symmetric(b) && Transitive(b)) {
+		return nil, nil, ErrNotEquivalence
+	}
+
+	elems := b.Universe().Elements()
+	uf := newUnionFind(elems)
+
+	for _, x := range elems {
+		for _, y := range elems {
+			if b.ContainsRelation(x, y) {
+				uf.union(x, y)
+			}
+		}
+	}
+
+	return uf, elems, nil
+}
+
+// --- }}}
+
+// --- Union-Find {{{
+
+// unionFind is a disjoint-set structure over a fixed collection of
+// set.Elements, used internally to compute equivalence classes.
+type unionFind struct {
+	parent map[set.Element]set.Element
+}
+
+func newUnionFind(elems []set.Element) *unionFind {
+	parent := make(map[set.Element]set.Element, len(elems))
+	for _, e := range elems {
+		parent[e] = e
+	}
+
+	return &unionFind{parent: parent}
+}
+
+func (u *unionFind) find(x set.Element) set.Element {
+	for u.parent[x] != x {
+		x = u.parent[x]
+	}
+
+	return x
+}
+
+func (u *unionFind) union(x, y set.Element) {
+	rx, ry := u.find(x), u.find(y)
+	if rx != ry {
+		u.parent[rx] = ry
+	}
+}
+
+// --- }}}
@@ -0,0 +1,49 @@
+package relation
+
+import "testing"
+
+func TestEquivalenceClassesAndQuotient(t *testing.T) {
+	u := newUniverse(1, 2, 3, 4)
+
+	b := New(u)
+	for _, e := range []int{1, 2, 3, 4} {
+		b.AddRelation(e, e)
+	}
+	b.AddRelation(1, 2)
+	b.AddRelation(2, 1)
+
+	classes, err := EquivalenceClasses(b)
+	if err != nil {
+		t.Fatalf("EquivalenceClasses: unexpected error: %v", err)
+	}
+	if len(classes) != 3 {
+		t.Fatalf("EquivalenceClasses: expected 3 classes ({1,2}, {3}, {4}), got %d", len(classes))
+	}
+
+	q, pi, err := Quotient(b)
+	if err != nil {
+		t.Fatalf("Quotient: unexpected error: %v", err)
+	}
+	if len(q.Elements()) != 3 {
+		t.Fatalf("Quotient: expected 3 representatives, got %d", len(q.Elements()))
+	}
+	if pi(1) != pi(2) {
+		t.Errorf("Quotient: expected 1 and 2 to project to the same representative")
+	}
+	if pi(3) == pi(4) {
+		t.Errorf("Quotient: did not expect 3 and 4 to project to the same representative")
+	}
+}
+
+func TestEquivalenceClassesRejectsNonEquivalence(t *testing.T) {
+	u := newUniverse(1, 2)
+	b := New(u)
+	b.AddRelation(1, 2) // neither reflexive nor symmetric
+
+	if _, err := EquivalenceClasses(b); err != ErrNotEquivalence {
+		t.Errorf("EquivalenceClasses: expected ErrNotEquivalence, got %v", err)
+	}
+	if _, _, err := Quotient(b); err != ErrNotEquivalence {
+		t.Errorf("Quotient: expected ErrNotEquivalence, got %v", err)
+	}
+}
@@ -0,0 +1,290 @@
+package relation
+
+import "github.com/nlandolfi/set"
+
+// --- Further Properties {{{
+
+// Irreflexive checks the following condition:
+//	 not xBx for any x ∈ X ≡ Universe()
+func Irreflexive(b AbstractInterface) bool {
+	for _, e := range b.Universe().Elements() {
+		if b.ContainsRelation(e, e) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Asymmetric checks the following condition:
+//	 xBy ⇒  not yBx for any x, y ∈ X ≡ Universe()
+func Asymmetric(b AbstractInterface) bool {
+	elems := b.Universe().Elements()
+	for _, x := range elems {
+		for _, y := range elems {
+			if b.ContainsRelation(x, y) && b.ContainsRelation(y, x) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Total checks the following condition:
+//	 xBy or yBx for any x, y ∈ X ≡ Universe()
+// It is an alias for Complete; also known as Connex.
+func Total(b AbstractInterface) bool {
+	return Complete(b)
+}
+
+// Trichotomous checks the following condition:
+//	 exactly one of xBy, yBx, x = y holds for any x, y ∈ X ≡ Universe()
+func Trichotomous(b AbstractInterface) bool {
+	elems := b.Universe().Elements()
+	for _, x := range elems {
+		for _, y := range elems {
+			n := 0
+			if b.ContainsRelation(x, y) {
+				n++
+			}
+			if b.ContainsRelation(y, x) {
+				n++
+			}
+			if x == y {
+				n++
+			}
+			if n != 1 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Euclidean checks the following condition:
+//	 (xBy and xBz) ⇒  yBz for any x, y, z ∈ X ≡ Universe()
+func Euclidean(b AbstractInterface) bool {
+	elems := b.Universe().Elements()
+	for _, x := range elems {
+		for _, y := range elems {
+			for _, z := range elems {
+				if b.ContainsRelation(x, y) && b.ContainsRelation(x, z) {
+					if !b.ContainsRelation(y, z) {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// Serial checks the following condition:
+//	 for any x ∈ X ≡ Universe(), there exists y ∈ X such that xBy
+func Serial(b AbstractInterface) bool {
+	for _, x := range b.Universe().Elements() {
+		found := false
+		for _, y := range b.Universe().Elements() {
+			if b.ContainsRelation(x, y) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// --- }}}
+
+// --- Named Order Constructors {{{
+
+// Witness is a pair of elements that violate the property being checked,
+// returned by the Is* order constructors below as a counterexample. This
+// makes them usable as test helpers, not just boolean predicates.
+type Witness struct {
+	X, Y set.Element
+}
+
+// IsPreorder checks that b is Reflexive and Transitive, returning a
+// Witness to the first violation found, if any.
+func IsPreorder(b AbstractInterface) (bool, *Witness) {
+	if w := reflexiveWitness(b); w != nil {
+		return false, w
+	}
+	if w := transitiveWitness(b); w != nil {
+		return false, w
+	}
+
+	return true, nil
+}
+
+// IsEquivalence checks that b is Reflexive, Symmetric, and Transitive,
+// returning a Witness to the first violation found, if any.
+func IsEquivalence(b AbstractInterface) (bool, *Witness) {
+	if w := reflexiveWitness(b); w != nil {
+		return false, w
+	}
+	if w := symmetricWitness(b); w != nil {
+		return false, w
+	}
+	if w := transitiveWitness(b); w != nil {
+		return false, w
+	}
+
+	return true, nil
+}
+
+// IsPartialOrder checks that b is a Preorder and additionally
+// AntiSymmetric, returning a Witness to the first violation found, if any.
+func IsPartialOrder(b AbstractInterface) (bool, *Witness) {
+	if ok, w := IsPreorder(b); !ok {
+		return false, w
+	}
+	if w := antiSymmetricWitness(b); w != nil {
+		return false, w
+	}
+
+	return true, nil
+}
+
+// IsTotalOrder checks that b is a PartialOrder and additionally Complete,
+// returning a Witness to the first violation found, if any.
+func IsTotalOrder(b AbstractInterface) (bool, *Witness) {
+	if ok, w := IsPartialOrder(b); !ok {
+		return false, w
+	}
+	if w := completeWitness(b); w != nil {
+		return false, w
+	}
+
+	return true, nil
+}
+
+// IsStrictPartialOrder checks that b is Irreflexive and Transitive,
+// returning a Witness to the first violation found, if any.
+func IsStrictPartialOrder(b AbstractInterface) (bool, *Witness) {
+	if w := irreflexiveWitness(b); w != nil {
+		return false, w
+	}
+	if w := transitiveWitness(b); w != nil {
+		return false, w
+	}
+
+	return true, nil
+}
+
+// IsStrictTotalOrder checks that b is a StrictPartialOrder and additionally
+// Trichotomous, returning a Witness to the first violation found, if any.
+func IsStrictTotalOrder(b AbstractInterface) (bool, *Witness) {
+	if ok, w := IsStrictPartialOrder(b); !ok {
+		return false, w
+	}
+
+	elems := b.Universe().Elements()
+	for _, x := range elems {
+		for _, y := range elems {
+			n := 0
+			if b.ContainsRelation(x, y) {
+				n++
+			}
+			if b.ContainsRelation(y, x) {
+				n++
+			}
+			if x == y {
+				n++
+			}
+			if n != 1 {
+				return false, &Witness{X: x, Y: y}
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// --- }}}
+
+// --- Witness Helpers {{{
+
+func reflexiveWitness(b AbstractInterface) *Witness {
+	for _, e := range b.Universe().Elements() {
+		if !b.ContainsRelation(e, e) {
+			return &Witness{X: e, Y: e}
+		}
+	}
+
+	return nil
+}
+
+func irreflexiveWitness(b AbstractInterface) *Witness {
+	for _, e := range b.Universe().Elements() {
+		if b.ContainsRelation(e, e) {
+			return &Witness{X: e, Y: e}
+		}
+	}
+
+	return nil
+}
+
+func symmetricWitness(b AbstractInterface) *Witness {
+	elems := b.Universe().Elements()
+	for _, x := range elems {
+		for _, y := range elems {
+			if b.ContainsRelation(x, y) && !b.ContainsRelation(y, x) {
+				return &Witness{X: x, Y: y}
+			}
+		}
+	}
+
+	return nil
+}
+
+func transitiveWitness(b AbstractInterface) *Witness {
+	elems := b.Universe().Elements()
+	for _, x := range elems {
+		for _, y := range elems {
+			for _, z := range elems {
+				if b.ContainsRelation(x, y) && b.ContainsRelation(y, z) && !b.ContainsRelation(x, z) {
+					return &Witness{X: x, Y: z}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func antiSymmetricWitness(b AbstractInterface) *Witness {
+	elems := b.Universe().Elements()
+	for _, x := range elems {
+		for _, y := range elems {
+			if b.ContainsRelation(x, y) && b.ContainsRelation(y, x) && x != y {
+				return &Witness{X: x, Y: y}
+			}
+		}
+	}
+
+	return nil
+}
+
+func completeWitness(b AbstractInterface) *Witness {
+	elems := b.Universe().Elements()
+	for _, x := range elems {
+		for _, y := range elems {
+			if !(b.ContainsRelation(x, y) || b.ContainsRelation(y, x)) {
+				return &Witness{X: x, Y: y}
+			}
+		}
+	}
+
+	return nil
+}
+
+// --- }}}
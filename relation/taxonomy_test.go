@@ -0,0 +1,114 @@
+package relation
+
+import "testing"
+
+// TestSymmetricBugFix is a regression test for the Symmetric check, whose
+// inner condition used to re-test ContainsRelation(x, y) instead of
+// ContainsRelation(y, x) and so was always true.
+func TestSymmetricBugFix(t *testing.T) {
+	u := newUniverse(1, 2)
+
+	b := New(u)
+	b.AddRelation(1, 2)
+	b.AddRelation(2, 1)
+
+	if !Symmetric(b) {
+		t.Errorf("Symmetric: expected true when both (1, 2) and (2, 1) hold")
+	}
+
+	b2 := New(u)
+	b2.AddRelation(1, 2)
+
+	if Symmetric(b2) {
+		t.Errorf("Symmetric: expected false when (2, 1) is missing")
+	}
+}
+
+// TestTransitiveDoesNotRequireComplete is a regression test: Transitive
+// used to short-circuit to false whenever Complete(b) was false, so a
+// non-complete but genuinely transitive relation was misreported.
+func TestTransitiveDoesNotRequireComplete(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	b := New(u)
+	b.AddRelation(1, 2)
+	b.AddRelation(2, 3)
+	b.AddRelation(1, 3)
+	// b is not Complete: e.g. (1, 1) does not hold.
+
+	if !Transitive(b) {
+		t.Errorf("Transitive: expected true for a non-complete but transitive relation")
+	}
+}
+
+func TestIrreflexiveAsymmetricSerial(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	b := New(u)
+	b.AddRelation(1, 2)
+	b.AddRelation(2, 3)
+	b.AddRelation(3, 1)
+
+	if !Irreflexive(b) {
+		t.Errorf("Irreflexive: expected true; no element relates to itself")
+	}
+	if !Asymmetric(b) {
+		t.Errorf("Asymmetric: expected true; no pair holds both ways")
+	}
+	if !Serial(b) {
+		t.Errorf("Serial: expected true; every element has an outgoing relation")
+	}
+}
+
+func TestTrichotomousAndEuclidean(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	b := New(u)
+	b.AddRelation(1, 2)
+	b.AddRelation(1, 3)
+	b.AddRelation(2, 3)
+
+	if !Trichotomous(b) {
+		t.Errorf("Trichotomous: expected true for the strict total order 1 < 2 < 3")
+	}
+
+	e := New(u)
+	for _, x := range []int{1, 2, 3} {
+		for _, y := range []int{1, 2, 3} {
+			e.AddRelation(x, y)
+		}
+	}
+	if !Euclidean(e) {
+		t.Errorf("Euclidean: expected true for the total relation")
+	}
+}
+
+func TestIsPreorderWitness(t *testing.T) {
+	u := newUniverse(1, 2)
+
+	b := New(u)
+	b.AddRelation(1, 2)
+	// missing reflexivity
+
+	ok, w := IsPreorder(b)
+	if ok {
+		t.Fatalf("IsPreorder: expected false")
+	}
+	if w == nil {
+		t.Fatalf("IsPreorder: expected a witness")
+	}
+}
+
+func TestIsStrictTotalOrder(t *testing.T) {
+	u := newUniverse(1, 2, 3)
+
+	b := New(u)
+	b.AddRelation(1, 2)
+	b.AddRelation(1, 3)
+	b.AddRelation(2, 3)
+
+	ok, w := IsStrictTotalOrder(b)
+	if !ok {
+		t.Fatalf("IsStrictTotalOrder: expected true, witness %+v", w)
+	}
+}
@@ -0,0 +1,14 @@
+package relation
+
+import "github.com/nlandolfi/set"
+
+// newUniverse builds a set.Interface containing exactly the given elements,
+// for use as a fixed universe in tests.
+func newUniverse(elems ...int) set.Interface {
+	u := set.New()
+	for _, e := range elems {
+		u.Add(e)
+	}
+
+	return u
+}